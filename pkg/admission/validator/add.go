@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// +kubebuilder:rbac:groups=extensions.gardener.cloud,resources=bastions,verbs=get;list;watch
+// +kubebuilder:rbac:groups=extensions.gardener.cloud,resources=clusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=extensions.gardener.cloud,resources=infrastructures,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.gardener.cloud,resources=secretbindings;credentialsbindings,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// Validators returns the validators to be registered with the generic validation webhook, keyed
+// by the object types they validate.
+func Validators(mgr manager.Manager, awsClientFactory awsclient.Factory) map[extensionswebhook.Validator][]extensionswebhook.Type {
+	return map[extensionswebhook.Validator][]extensionswebhook.Type{
+		NewBastionValidator(mgr, awsClientFactory): {{Obj: &extensionsv1alpha1.Bastion{}}},
+	}
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"context"
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	bastionctrl "github.com/gardener/gardener-extension-provider-aws/pkg/controller/bastion"
+)
+
+// bastion validates create and update operations on Bastion resources.
+type bastion struct {
+	client           client.Client
+	awsClientFactory awsclient.Factory
+}
+
+// NewBastionValidator returns a new instance of a Bastion validator that pre-flights AMI,
+// region and instance-type availability by running the same `bastion.DetermineOptions` logic
+// used during reconciliation, so that unresolvable Bastion resources are rejected at admission
+// time rather than getting stuck in reconciliation.
+func NewBastionValidator(mgr manager.Manager, awsClientFactory awsclient.Factory) extensionswebhook.Validator {
+	return &bastion{
+		client:           mgr.GetClient(),
+		awsClientFactory: awsClientFactory,
+	}
+}
+
+// Validate implements extensionswebhook.Validator.
+func (b *bastion) Validate(ctx context.Context, newObj, oldObj client.Object) error {
+	bastionResource, ok := newObj.(*extensionsv1alpha1.Bastion)
+	if !ok {
+		return fmt.Errorf("wrong object type %T", newObj)
+	}
+
+	if oldObj != nil {
+		oldBastion, ok := oldObj.(*extensionsv1alpha1.Bastion)
+		if !ok {
+			return fmt.Errorf("wrong object type %T", oldObj)
+		}
+		// only re-validate when the spec actually changed, status-only updates are frequent
+		if equality.Semantic.DeepEqual(bastionResource.Spec, oldBastion.Spec) {
+			return nil
+		}
+	}
+
+	cluster, err := extensionscontroller.GetCluster(ctx, b.client, bastionResource.Namespace)
+	if err != nil {
+		return fmt.Errorf("could not get cluster for bastion %q: %w", client.ObjectKeyFromObject(bastionResource), err)
+	}
+
+	secretBindingName, err := bastionctrl.ResolveSecretBindingName(cluster.Shoot)
+	if err != nil {
+		return err
+	}
+
+	awsClient, err := b.awsClientFactory.NewClient(ctx, b.client, bastionResource.Namespace, secretBindingName, cluster.Shoot.Spec.Region)
+	if err != nil {
+		return fmt.Errorf("failed to create AWS client: %w", err)
+	}
+
+	// DetermineOptions resolves the same AMI and instance type (pre-flighting their
+	// availability in the shoot's region, and the instance type's availability in the bastion's
+	// target zone in particular) that reconciliation would pick, so any failure here reliably
+	// predicts a reconcile failure. An instance type pinned via CloudProfileConfig.Bastion.MachineType
+	// is used as configured, without checking its availability in the zone.
+	if _, err := bastionctrl.DetermineOptions(ctx, b.client, bastionResource, cluster, awsClient); err != nil {
+		return fmt.Errorf("bastion cannot be reconciled: %w", err)
+	}
+
+	return nil
+}
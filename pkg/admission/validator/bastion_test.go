@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	awsv1alpha1 "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
+)
+
+func TestBastionValidator(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Bastion Admission Validator Suite")
+}
+
+const (
+	testNamespace         = "shoot--foo--bar"
+	testShootName         = "shoot--foo--bar"
+	testSecretBindingName = "my-secretbinding"
+	testRegion            = "eu-west-1"
+	testZone              = "eu-west-1a"
+	testAMI               = "ami-1234567890"
+	testVPCID             = "vpc-1"
+	testSubnetID          = "subnet-1"
+)
+
+// stubAWSClientFactory always hands out the same preconfigured *awsclient.Client, regardless
+// of the requested namespace/secret binding/region, which is all the bastion validator needs
+// for these tests.
+type stubAWSClientFactory struct {
+	client *awsclient.Client
+}
+
+func (f *stubAWSClientFactory) NewClient(_ context.Context, _ client.Client, _, _, _ string) (*awsclient.Client, error) {
+	return f.client, nil
+}
+
+func newTestBastion() *extensionsv1alpha1.Bastion {
+	return &extensionsv1alpha1.Bastion{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-bastion", Namespace: testNamespace},
+	}
+}
+
+func newTestInfrastructure() *extensionsv1alpha1.Infrastructure {
+	infrastructureStatus := &awsv1alpha1.InfrastructureStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: awsv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "InfrastructureStatus",
+		},
+		VPC: awsv1alpha1.VPCStatus{
+			ID: aws.String(testVPCID),
+			Subnets: []awsv1alpha1.Subnet{
+				{ID: testSubnetID, Purpose: awsv1alpha1.PurposePublic, Zone: testZone},
+			},
+		},
+	}
+
+	return &extensionsv1alpha1.Infrastructure{
+		ObjectMeta: metav1.ObjectMeta{Name: testShootName, Namespace: testNamespace},
+		Status: extensionsv1alpha1.InfrastructureStatus{
+			DefaultStatus: extensionsv1alpha1.DefaultStatus{
+				ProviderStatus: &runtime.RawExtension{Raw: mustMarshal(infrastructureStatus)},
+			},
+		},
+	}
+}
+
+// newTestCluster builds a minimal Cluster resource whose embedded Shoot and CloudProfile
+// resolve a single bastion-eligible AMI for testRegion, so that DetermineOptions succeeds up to
+// the AWS-side checks under test.
+func newTestCluster() *extensionsv1alpha1.Cluster {
+	cloudProfileConfig := &awsv1alpha1.CloudProfileConfig{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: awsv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "CloudProfileConfig",
+		},
+		MachineImages: []awsv1alpha1.MachineImages{
+			{
+				Name: "gardenlinux",
+				Versions: []awsv1alpha1.MachineImageVersion{
+					{
+						Version: "1312.3.0",
+						Regions: []awsv1alpha1.RegionAMIMapping{
+							{Name: testRegion, AMI: testAMI},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	shoot := &gardencorev1beta1.Shoot{
+		TypeMeta:   metav1.TypeMeta{APIVersion: gardencorev1beta1.SchemeGroupVersion.String(), Kind: "Shoot"},
+		ObjectMeta: metav1.ObjectMeta{Name: testShootName},
+		Spec: gardencorev1beta1.ShootSpec{
+			Region:            testRegion,
+			SecretBindingName: aws.String(testSecretBindingName),
+		},
+	}
+
+	cloudProfile := &gardencorev1beta1.CloudProfile{
+		TypeMeta: metav1.TypeMeta{APIVersion: gardencorev1beta1.SchemeGroupVersion.String(), Kind: "CloudProfile"},
+		Spec: gardencorev1beta1.CloudProfileSpec{
+			ProviderConfig: &runtime.RawExtension{Raw: mustMarshal(cloudProfileConfig)},
+		},
+	}
+
+	return &extensionsv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: testNamespace},
+		Spec: extensionsv1alpha1.ClusterSpec{
+			Shoot:        runtime.RawExtension{Raw: mustMarshal(shoot)},
+			CloudProfile: runtime.RawExtension{Raw: mustMarshal(cloudProfile)},
+		},
+	}
+}
+
+func mustMarshal(obj interface{}) []byte {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+var _ = Describe("bastion validator", func() {
+	var (
+		ctrl      *gomock.Controller
+		ec2Client *mockawsclient.MockInterface
+		factory   *stubAWSClientFactory
+		v         *bastion
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ec2Client = mockawsclient.NewMockInterface(ctrl)
+		factory = &stubAWSClientFactory{client: &awsclient.Client{Region: testRegion, EC2: ec2Client}}
+		v = &bastion{
+			client:           fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(newTestCluster(), newTestInfrastructure()).Build(),
+			awsClientFactory: factory,
+		}
+
+		ec2Client.EXPECT().DescribeSecurityGroups(gomock.Any(), gomock.Any()).Return(&ec2.DescribeSecurityGroupsOutput{
+			SecurityGroups: []ec2types.SecurityGroup{{GroupId: aws.String("sg-1")}},
+		}, nil)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("rejects a Bastion when no instance type offerings exist in the target zone", func() {
+		ec2Client.EXPECT().DescribeImages(gomock.Any(), gomock.Any()).Return(&ec2.DescribeImagesOutput{
+			Images: []ec2types.Image{{Architecture: ec2types.ArchitectureValuesArm64}},
+		}, nil)
+		ec2Client.EXPECT().DescribeInstanceTypeOfferings(gomock.Any(), gomock.Any()).Return(&ec2.DescribeInstanceTypeOfferingsOutput{}, nil).AnyTimes()
+		ec2Client.EXPECT().DescribeInstanceTypes(gomock.Any(), gomock.Any()).Times(0)
+
+		err := v.Validate(context.Background(), newTestBastion(), nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("accepts a Bastion whose AMI and instance type are both available in the target zone", func() {
+		ec2Client.EXPECT().DescribeImages(gomock.Any(), gomock.Any()).Return(&ec2.DescribeImagesOutput{
+			Images: []ec2types.Image{{Architecture: ec2types.ArchitectureValuesArm64}},
+		}, nil)
+		ec2Client.EXPECT().DescribeInstanceTypeOfferings(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, in *ec2.DescribeInstanceTypeOfferingsInput, _ ...func(*ec2.Options)) (*ec2.DescribeInstanceTypeOfferingsOutput, error) {
+				// only the bastion's target zone (testZone) has the candidate instance type offered
+				for _, filter := range in.Filters {
+					if *filter.Name == "location" && filter.Values[0] != testZone {
+						return &ec2.DescribeInstanceTypeOfferingsOutput{}, nil
+					}
+				}
+				return &ec2.DescribeInstanceTypeOfferingsOutput{
+					InstanceTypeOfferings: []ec2types.InstanceTypeOffering{{InstanceType: ec2types.InstanceTypeT4gNano}},
+				}, nil
+			}).AnyTimes()
+
+		err := v.Validate(context.Background(), newTestBastion(), nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
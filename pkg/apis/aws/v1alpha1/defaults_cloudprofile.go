@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+// SetDefaults_CloudProfileConfig sets default values for CloudProfileConfig objects.
+func SetDefaults_CloudProfileConfig(obj *CloudProfileConfig) {
+	if obj.Bastion == nil || obj.Bastion.MachineType == nil {
+		return
+	}
+
+	for i, region := range obj.Bastion.MachineType.Regions {
+		if len(region.MachineType) == 0 {
+			obj.Bastion.MachineType.Regions[i].MachineType = obj.Bastion.MachineType.Name
+		}
+	}
+}
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+)
+
+func TestDefaults(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CloudProfileConfig Defaulting Suite")
+}
+
+var _ = Describe("SetDefaults_CloudProfileConfig", func() {
+	It("does nothing when no bastion section is set", func() {
+		obj := &CloudProfileConfig{}
+		SetDefaults_CloudProfileConfig(obj)
+		Expect(obj.Bastion).To(BeNil())
+	})
+
+	It("defaults empty per-region machine type overrides to the top-level machine type", func() {
+		obj := &CloudProfileConfig{
+			Bastion: &Bastion{
+				MachineImage: BastionMachineImage{Name: "gardenlinux"},
+				MachineType: &BastionMachineType{
+					Name: "t4g.nano",
+					Regions: []RegionMachineTypeMapping{
+						{Name: "eu-west-1"},
+						{Name: "eu-central-1", MachineType: "t3.nano"},
+					},
+				},
+			},
+		}
+
+		SetDefaults_CloudProfileConfig(obj)
+
+		Expect(obj.Bastion.MachineType.Regions[0].MachineType).To(Equal("t4g.nano"))
+		Expect(obj.Bastion.MachineType.Regions[1].MachineType).To(Equal("t3.nano"))
+	})
+})
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+// Bastion contains the machine image and machine type that should be used for bastion
+// instances, as a first-class alternative to the gardenlinux-version-convention hack.
+type Bastion struct {
+	// MachineImage is the machine image to use for the bastion instance.
+	MachineImage BastionMachineImage `json:"machineImage"`
+	// MachineType is the instance type to use for the bastion instance. If not set, an instance
+	// type is picked automatically from InstanceTypeCandidates (or built-in defaults).
+	// +optional
+	MachineType *BastionMachineType `json:"machineType,omitempty"`
+	// InstanceTypeCandidates is an ordered list of instance types to try per CPU architecture
+	// when MachineType is not set, before falling back to scanning all "t*" instance type
+	// offerings. The first candidate that is actually offered in the bastion's availability zone
+	// is used, so operators can influence the fallback selection without hardcoding a single
+	// machine type.
+	// +optional
+	InstanceTypeCandidates []InstanceTypeCandidates `json:"instanceTypeCandidates,omitempty"`
+}
+
+// InstanceTypeCandidates is an ordered list of instance type candidates for one CPU
+// architecture.
+type InstanceTypeCandidates struct {
+	// Architecture is the CPU architecture these candidates apply to, e.g. "x86_64" or "arm64".
+	Architecture string `json:"architecture"`
+	// InstanceTypes is the ordered list of instance types to try, smallest/cheapest first.
+	InstanceTypes []string `json:"instanceTypes"`
+}
+
+// BastionMachineImage contains the machine image name and version to be used for bastions,
+// as well as a tag-based selector and per-region overrides.
+type BastionMachineImage struct {
+	// Name is the name of the machine image.
+	Name string `json:"name"`
+	// Version is the version of the machine image. If not provided, the highest version of the
+	// machine image of the given name will be used. Validation requires Version when neither
+	// Regions nor AMISelector is set.
+	// +optional
+	Version *string `json:"version,omitempty"`
+	// Regions is an optional list of per-region AMI overrides. If the shoot's region is not
+	// listed here, the AMI is looked up in the MachineImages section using Name/Version.
+	// +optional
+	Regions []RegionAMIMapping `json:"regions,omitempty"`
+	// AMISelector allows selecting the bastion AMI by EC2 image tags instead of pinning a
+	// specific AMI ID, analogous to Karpenter's AMISelector. If set, it takes precedence over
+	// Version and Regions.
+	// +optional
+	AMISelector map[string]string `json:"amiSelector,omitempty"`
+}
+
+// BastionMachineType contains the instance type to use for bastions, with optional per-region
+// overrides.
+type BastionMachineType struct {
+	// Name is the name of the instance type.
+	Name string `json:"name"`
+	// Regions is an optional list of per-region instance type overrides.
+	// +optional
+	Regions []RegionMachineTypeMapping `json:"regions,omitempty"`
+}
+
+// RegionMachineTypeMapping is a mapping of a region to an instance type.
+type RegionMachineTypeMapping struct {
+	// Name is the name of the region.
+	Name string `json:"name"`
+	// MachineType is the instance type to use in the corresponding region.
+	MachineType string `json:"machineType"`
+}
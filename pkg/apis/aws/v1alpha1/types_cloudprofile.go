@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CloudProfileConfig contains provider-specific configuration that is embedded into Gardener's
+// `CloudProfile` resource.
+type CloudProfileConfig struct {
+	metav1.TypeMeta `json:",inline"`
+	// MachineImages is the list of machine images that are understood by the controller. It
+	// maps logical names and versions to provider-specific identifiers.
+	MachineImages []MachineImages `json:"machineImages"`
+	// Bastion contains the machine image and machine type that should be used for bastion
+	// instances. If this is not set, the controller falls back to deriving them from
+	// MachineImages and the instance-type offerings of the target account.
+	// +optional
+	Bastion *Bastion `json:"bastion,omitempty"`
+}
+
+// MachineImages is a mapping from logical names and versions to provider-specific identifiers.
+type MachineImages struct {
+	// Name is the logical name of the machine image.
+	Name string `json:"name"`
+	// Versions contains versions and their regional AMI mappings.
+	Versions []MachineImageVersion `json:"versions"`
+}
+
+// MachineImageVersion contains a version and a mapping to the provider-specific machine image
+// per region.
+type MachineImageVersion struct {
+	// Version is the version of the image.
+	Version string `json:"version"`
+	// Regions is a mapping to the correct AMI for the corresponding region.
+	Regions []RegionAMIMapping `json:"regions"`
+}
+
+// RegionAMIMapping is a mapping of a region to an Amazon Machine Image.
+type RegionAMIMapping struct {
+	// Name is the name of the region.
+	Name string `json:"name"`
+	// AMI is the image ID for the corresponding region.
+	AMI string `json:"ami"`
+}
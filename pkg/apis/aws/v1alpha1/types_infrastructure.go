@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// InfrastructureStatus contains information about the deployed infrastructure resources.
+type InfrastructureStatus struct {
+	metav1.TypeMeta `json:",inline"`
+	// VPC contains information about the VPC and its subnets.
+	VPC VPCStatus `json:"vpc"`
+}
+
+// VPCStatus contains the VPC ID and the subnets that were created inside it.
+type VPCStatus struct {
+	// ID is the VPC ID.
+	ID *string `json:"id,omitempty"`
+	// Subnets is a list of subnets that have been created.
+	Subnets []Subnet `json:"subnets"`
+}
+
+// SubnetPurpose is a purpose of a subnet.
+type SubnetPurpose string
+
+const (
+	// PurposePublic is a constant describing a subnet that is used for public load balancers.
+	PurposePublic SubnetPurpose = "public"
+	// PurposeInternal is a constant describing a subnet that is used for internal load balancers.
+	PurposeInternal SubnetPurpose = "internal"
+	// PurposeNodes is a constant describing a subnet that is used for worker nodes.
+	PurposeNodes SubnetPurpose = "nodes"
+)
+
+// Subnet contains information about a subnet that has been created.
+type Subnet struct {
+	// ID is the subnet ID.
+	ID string `json:"id"`
+	// Purpose is the purpose use of this subnet, e.g. "public" or "nodes".
+	Purpose SubnetPurpose `json:"purpose"`
+	// Zone is the availability zone into which the subnet has been created.
+	Zone string `json:"zone"`
+}
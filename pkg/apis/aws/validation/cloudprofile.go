@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	awsv1alpha1 "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+)
+
+// ValidateCloudProfileConfig validates a CloudProfileConfig object.
+func ValidateCloudProfileConfig(cloudProfileConfig *awsv1alpha1.CloudProfileConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	machineImagesPath := fldPath.Child("machineImages")
+	for i, machineImage := range cloudProfileConfig.MachineImages {
+		idxPath := machineImagesPath.Index(i)
+		if len(machineImage.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		}
+		if len(machineImage.Versions) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("versions"), "must provide at least one version"))
+		}
+		for j, version := range machineImage.Versions {
+			jdxPath := idxPath.Child("versions").Index(j)
+			if len(version.Version) == 0 {
+				allErrs = append(allErrs, field.Required(jdxPath.Child("version"), "must provide a version"))
+			}
+			allErrs = append(allErrs, validateRegionAMIMappings(version.Regions, jdxPath.Child("regions"))...)
+		}
+	}
+
+	if cloudProfileConfig.Bastion != nil {
+		allErrs = append(allErrs, validateBastion(cloudProfileConfig.Bastion, fldPath.Child("bastion"))...)
+	}
+
+	return allErrs
+}
+
+func validateBastion(bastion *awsv1alpha1.Bastion, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	imagePath := fldPath.Child("machineImage")
+	image := bastion.MachineImage
+	if len(image.Name) == 0 {
+		allErrs = append(allErrs, field.Required(imagePath.Child("name"), "must provide a name"))
+	}
+
+	switch {
+	case len(image.AMISelector) > 0:
+		for key, value := range image.AMISelector {
+			if len(key) == 0 {
+				allErrs = append(allErrs, field.Invalid(imagePath.Child("amiSelector"), image.AMISelector, "tag keys must not be empty"))
+			}
+			if len(value) == 0 {
+				allErrs = append(allErrs, field.Invalid(imagePath.Child("amiSelector").Key(key), value, "tag values must not be empty"))
+			}
+		}
+	case len(image.Regions) > 0:
+		allErrs = append(allErrs, validateRegionAMIMappings(image.Regions, imagePath.Child("regions"))...)
+	case image.Version == nil:
+		allErrs = append(allErrs, field.Required(imagePath.Child("version"), "must provide a version, a list of regions, or an amiSelector"))
+	}
+
+	if bastion.MachineType != nil {
+		machineTypePath := fldPath.Child("machineType")
+		if len(bastion.MachineType.Name) == 0 {
+			allErrs = append(allErrs, field.Required(machineTypePath.Child("name"), "must provide a name"))
+		}
+		for i, region := range bastion.MachineType.Regions {
+			idxPath := machineTypePath.Child("regions").Index(i)
+			if len(region.Name) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+			}
+			if len(region.MachineType) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("machineType"), "must provide a machineType"))
+			}
+		}
+	}
+
+	candidatesPath := fldPath.Child("instanceTypeCandidates")
+	for i, candidates := range bastion.InstanceTypeCandidates {
+		idxPath := candidatesPath.Index(i)
+		if len(candidates.Architecture) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("architecture"), "must provide an architecture"))
+		}
+		if len(candidates.InstanceTypes) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("instanceTypes"), "must provide at least one instance type"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateRegionAMIMappings(regions []awsv1alpha1.RegionAMIMapping, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, region := range regions {
+		idxPath := fldPath.Index(i)
+		if len(region.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		}
+		if len(region.AMI) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("ami"), "must provide an ami"))
+		} else if !strings.HasPrefix(region.AMI, "ami-") {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("ami"), region.AMI, "must be a valid AMI id"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	awsv1alpha1 "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/validation"
+)
+
+func TestValidation(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CloudProfileConfig Validation Suite")
+}
+
+var _ = Describe("ValidateCloudProfileConfig", func() {
+	var cloudProfileConfig *awsv1alpha1.CloudProfileConfig
+
+	BeforeEach(func() {
+		cloudProfileConfig = &awsv1alpha1.CloudProfileConfig{
+			MachineImages: []awsv1alpha1.MachineImages{
+				{
+					Name: "gardenlinux",
+					Versions: []awsv1alpha1.MachineImageVersion{
+						{
+							Version: "1312.3.0",
+							Regions: []awsv1alpha1.RegionAMIMapping{{Name: "eu-west-1", AMI: "ami-1234567890"}},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	It("passes validation without a bastion section", func() {
+		Expect(ValidateCloudProfileConfig(cloudProfileConfig, field.NewPath("spec"))).To(BeEmpty())
+	})
+
+	It("requires a machine image name for the bastion", func() {
+		cloudProfileConfig.Bastion = &awsv1alpha1.Bastion{
+			MachineImage: awsv1alpha1.BastionMachineImage{
+				Regions: []awsv1alpha1.RegionAMIMapping{{Name: "eu-west-1", AMI: "ami-1234567890"}},
+			},
+		}
+
+		errs := ValidateCloudProfileConfig(cloudProfileConfig, field.NewPath("spec"))
+		Expect(errs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Field": Equal("spec.bastion.machineImage.name"),
+		}))))
+	})
+
+	It("requires a version, regions, or an amiSelector for the bastion image", func() {
+		cloudProfileConfig.Bastion = &awsv1alpha1.Bastion{
+			MachineImage: awsv1alpha1.BastionMachineImage{Name: "gardenlinux"},
+		}
+
+		errs := ValidateCloudProfileConfig(cloudProfileConfig, field.NewPath("spec"))
+		Expect(errs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Field": Equal("spec.bastion.machineImage.version"),
+		}))))
+	})
+
+	It("accepts a bastion image pinned via amiSelector", func() {
+		cloudProfileConfig.Bastion = &awsv1alpha1.Bastion{
+			MachineImage: awsv1alpha1.BastionMachineImage{
+				Name:        "gardenlinux",
+				AMISelector: map[string]string{"gardener.cloud/purpose": "bastion"},
+			},
+		}
+
+		Expect(ValidateCloudProfileConfig(cloudProfileConfig, field.NewPath("spec"))).To(BeEmpty())
+	})
+
+	It("rejects an invalid AMI id", func() {
+		cloudProfileConfig.Bastion = &awsv1alpha1.Bastion{
+			MachineImage: awsv1alpha1.BastionMachineImage{
+				Name:    "gardenlinux",
+				Regions: []awsv1alpha1.RegionAMIMapping{{Name: "eu-west-1", AMI: "not-an-ami"}},
+			},
+		}
+
+		errs := ValidateCloudProfileConfig(cloudProfileConfig, field.NewPath("spec"))
+		Expect(errs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Field": Equal("spec.bastion.machineImage.regions[0].ami"),
+		}))))
+	})
+})
@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BastionConfig contains configuration for the bastion controller and admission validator.
+type BastionConfig struct {
+	// EC2DescribeCacheTTL is the TTL for the in-process cache of EC2 describe calls
+	// (DescribeSubnets, DescribeImages, DescribeInstanceTypeOfferings) issued while determining
+	// bastion options. If not set, a built-in default is used.
+	// +optional
+	EC2DescribeCacheTTL *metav1.Duration `json:"ec2DescribeCacheTTL,omitempty"`
+}
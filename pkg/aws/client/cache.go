@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+)
+
+// DefaultCacheTTL is used when the extension's ControllerConfig does not configure a TTL for
+// the describe-call cache.
+const DefaultCacheTTL = 5 * time.Minute
+
+// cacheEntry holds a cached describe-call result together with the time it expires.
+type cacheEntry struct {
+	value  any
+	expiry time.Time
+}
+
+// CachingInterface wraps an Interface with a small in-process TTL cache, keyed by region and a
+// JSON-serialized representation of the request. It collects complete, paginated result sets
+// for DescribeSubnets, DescribeImages, DescribeInstanceTypeOfferings and DescribeSecurityGroups
+// so that callers never see a partial page, and shares identical describe calls for the same
+// region across multiple reconciliations (e.g. of different Bastions in the same shoot).
+type CachingInterface struct {
+	Interface
+
+	region string
+	ttl    time.Duration
+
+	mu    sync.Mutex
+	cache map[string]cacheEntry
+}
+
+// NewCachingInterface wraps the given Interface with a TTL cache, keyed by the given region. A
+// ttl of zero uses DefaultCacheTTL.
+func NewCachingInterface(delegate Interface, region string, ttl time.Duration) *CachingInterface {
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+
+	return &CachingInterface{
+		Interface: delegate,
+		region:    region,
+		ttl:       ttl,
+		cache:     map[string]cacheEntry{},
+	}
+}
+
+func cacheKey(region, op string, in any) string {
+	data, err := json.Marshal(in)
+	if err != nil {
+		// if the input cannot be marshaled, skip caching by returning a key that is never reused
+		return ""
+	}
+	return region + "|" + op + "|" + string(data)
+}
+
+func (c *CachingInterface) get(key string) (any, bool) {
+	if key == "" {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.cache[key]
+	if !ok || time.Now().After(entry.expiry) {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *CachingInterface) set(key string, value any) {
+	if key == "" {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.cache[key] = cacheEntry{value: value, expiry: time.Now().Add(c.ttl)}
+}
+
+// DescribeSubnets returns the complete (paginated) set of subnets matching the given input,
+// serving the result from cache when available.
+func (c *CachingInterface) DescribeSubnets(ctx context.Context, in *ec2.DescribeSubnetsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeSubnetsOutput, error) {
+	key := cacheKey(c.region, "DescribeSubnets", in)
+	if cached, ok := c.get(key); ok {
+		return cached.(*ec2.DescribeSubnetsOutput), nil
+	}
+
+	out := &ec2.DescribeSubnetsOutput{}
+	paginator := ec2.NewDescribeSubnetsPaginator(c.Interface, in)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx, optFns...)
+		if err != nil {
+			return nil, err
+		}
+		out.Subnets = append(out.Subnets, page.Subnets...)
+	}
+
+	c.set(key, out)
+	return out, nil
+}
+
+// DescribeImages returns the complete (paginated) set of images matching the given input,
+// serving the result from cache when available.
+func (c *CachingInterface) DescribeImages(ctx context.Context, in *ec2.DescribeImagesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeImagesOutput, error) {
+	key := cacheKey(c.region, "DescribeImages", in)
+	if cached, ok := c.get(key); ok {
+		return cached.(*ec2.DescribeImagesOutput), nil
+	}
+
+	out := &ec2.DescribeImagesOutput{}
+	paginator := ec2.NewDescribeImagesPaginator(c.Interface, in)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx, optFns...)
+		if err != nil {
+			return nil, err
+		}
+		out.Images = append(out.Images, page.Images...)
+	}
+
+	c.set(key, out)
+	return out, nil
+}
+
+// DescribeInstanceTypeOfferings returns the complete (paginated) set of instance type offerings
+// matching the given input, serving the result from cache when available.
+func (c *CachingInterface) DescribeInstanceTypeOfferings(ctx context.Context, in *ec2.DescribeInstanceTypeOfferingsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstanceTypeOfferingsOutput, error) {
+	key := cacheKey(c.region, "DescribeInstanceTypeOfferings", in)
+	if cached, ok := c.get(key); ok {
+		return cached.(*ec2.DescribeInstanceTypeOfferingsOutput), nil
+	}
+
+	out := &ec2.DescribeInstanceTypeOfferingsOutput{}
+	paginator := ec2.NewDescribeInstanceTypeOfferingsPaginator(c.Interface, in)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx, optFns...)
+		if err != nil {
+			return nil, err
+		}
+		out.InstanceTypeOfferings = append(out.InstanceTypeOfferings, page.InstanceTypeOfferings...)
+	}
+
+	c.set(key, out)
+	return out, nil
+}
+
+// DescribeSecurityGroups returns the complete (paginated) set of security groups matching the
+// given input, serving the result from cache when available.
+func (c *CachingInterface) DescribeSecurityGroups(ctx context.Context, in *ec2.DescribeSecurityGroupsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeSecurityGroupsOutput, error) {
+	key := cacheKey(c.region, "DescribeSecurityGroups", in)
+	if cached, ok := c.get(key); ok {
+		return cached.(*ec2.DescribeSecurityGroupsOutput), nil
+	}
+
+	out := &ec2.DescribeSecurityGroupsOutput{}
+	paginator := ec2.NewDescribeSecurityGroupsPaginator(c.Interface, in)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx, optFns...)
+		if err != nil {
+			return nil, err
+		}
+		out.SecurityGroups = append(out.SecurityGroups, page.SecurityGroups...)
+	}
+
+	c.set(key, out)
+	return out, nil
+}
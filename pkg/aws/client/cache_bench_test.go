@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// BenchmarkCachingInterface_DescribeSubnets simulates repeated DetermineOptions invocations for
+// the same shoot region, demonstrating that the cache avoids re-issuing the underlying
+// DescribeSubnets call on every reconcile.
+func BenchmarkCachingInterface_DescribeSubnets(b *testing.B) {
+	fake := &fakeEC2{subnetPages: [][]ec2types.Subnet{{{SubnetId: aws.String("subnet-a")}}}}
+	cache := awsclient.NewCachingInterface(fake, "eu-west-1", time.Minute)
+	in := &ec2.DescribeSubnetsInput{}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := cache.DescribeSubnets(context.Background(), in); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+func TestClient(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "AWS Client Suite")
+}
+
+// fakeEC2 is a minimal hand-rolled fake implementing awsclient.Interface, used to verify
+// caching and pagination behavior without pulling in a full mock.
+type fakeEC2 struct {
+	describeSubnetsCalls int
+	subnetPages          [][]ec2types.Subnet
+}
+
+func (f *fakeEC2) DescribeSubnets(_ context.Context, in *ec2.DescribeSubnetsInput, _ ...func(*ec2.Options)) (*ec2.DescribeSubnetsOutput, error) {
+	f.describeSubnetsCalls++
+
+	pageIndex := 0
+	if in.NextToken != nil {
+		pageIndex, _ = strconv.Atoi(*in.NextToken)
+	}
+
+	out := &ec2.DescribeSubnetsOutput{Subnets: f.subnetPages[pageIndex]}
+	if pageIndex+1 < len(f.subnetPages) {
+		nextToken := strconv.Itoa(pageIndex + 1)
+		out.NextToken = &nextToken
+	}
+	return out, nil
+}
+
+func (f *fakeEC2) DescribeSecurityGroups(context.Context, *ec2.DescribeSecurityGroupsInput, ...func(*ec2.Options)) (*ec2.DescribeSecurityGroupsOutput, error) {
+	return &ec2.DescribeSecurityGroupsOutput{}, nil
+}
+
+func (f *fakeEC2) DescribeImages(context.Context, *ec2.DescribeImagesInput, ...func(*ec2.Options)) (*ec2.DescribeImagesOutput, error) {
+	return &ec2.DescribeImagesOutput{}, nil
+}
+
+func (f *fakeEC2) DescribeInstanceTypeOfferings(context.Context, *ec2.DescribeInstanceTypeOfferingsInput, ...func(*ec2.Options)) (*ec2.DescribeInstanceTypeOfferingsOutput, error) {
+	return &ec2.DescribeInstanceTypeOfferingsOutput{}, nil
+}
+
+func (f *fakeEC2) DescribeInstanceTypes(context.Context, *ec2.DescribeInstanceTypesInput, ...func(*ec2.Options)) (*ec2.DescribeInstanceTypesOutput, error) {
+	return &ec2.DescribeInstanceTypesOutput{}, nil
+}
+
+var _ = Describe("CachingInterface", func() {
+	It("collects all pages and shares the cached result across repeated calls", func() {
+		fake := &fakeEC2{subnetPages: [][]ec2types.Subnet{
+			{{SubnetId: aws.String("subnet-a")}},
+			{{SubnetId: aws.String("subnet-b")}},
+		}}
+		cache := awsclient.NewCachingInterface(fake, "eu-west-1", time.Minute)
+
+		in := &ec2.DescribeSubnetsInput{}
+		out, err := cache.DescribeSubnets(context.Background(), in)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.Subnets).To(HaveLen(2))
+		Expect(fake.describeSubnetsCalls).To(Equal(2), "should have followed the pagination token")
+
+		_, err = cache.DescribeSubnets(context.Background(), in)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fake.describeSubnetsCalls).To(Equal(2), "second call for the same input should be served from cache")
+	})
+
+	It("expires cached entries after the configured TTL", func() {
+		fake := &fakeEC2{subnetPages: [][]ec2types.Subnet{{{SubnetId: aws.String("subnet-a")}}}}
+		cache := awsclient.NewCachingInterface(fake, "eu-west-1", time.Millisecond)
+
+		in := &ec2.DescribeSubnetsInput{}
+		_, err := cache.DescribeSubnets(context.Background(), in)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fake.describeSubnetsCalls).To(Equal(1))
+
+		time.Sleep(5 * time.Millisecond)
+
+		_, err = cache.DescribeSubnets(context.Background(), in)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fake.describeSubnetsCalls).To(Equal(2), "call after TTL expiry should hit the delegate again")
+	})
+
+	It("keys cache entries by region, so two regions never share a result", func() {
+		fake := &fakeEC2{subnetPages: [][]ec2types.Subnet{{{SubnetId: aws.String("subnet-a")}}}}
+		cacheA := awsclient.NewCachingInterface(fake, "eu-west-1", time.Minute)
+		cacheB := awsclient.NewCachingInterface(fake, "eu-central-1", time.Minute)
+
+		in := &ec2.DescribeSubnetsInput{}
+		_, err := cacheA.DescribeSubnets(context.Background(), in)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = cacheB.DescribeSubnets(context.Background(), in)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fake.describeSubnetsCalls).To(Equal(2))
+	})
+})
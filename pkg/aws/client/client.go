@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Interface describes the subset of EC2 describe operations used throughout this repository.
+// It exists so that callers can be tested against a mock or a caching/paginating decorator
+// instead of the concrete *ec2.Client from the AWS SDK.
+type Interface interface {
+	DescribeSubnets(ctx context.Context, in *ec2.DescribeSubnetsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeSubnetsOutput, error)
+	DescribeSecurityGroups(ctx context.Context, in *ec2.DescribeSecurityGroupsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeSecurityGroupsOutput, error)
+	DescribeImages(ctx context.Context, in *ec2.DescribeImagesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeImagesOutput, error)
+	DescribeInstanceTypeOfferings(ctx context.Context, in *ec2.DescribeInstanceTypeOfferingsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstanceTypeOfferingsOutput, error)
+	DescribeInstanceTypes(ctx context.Context, in *ec2.DescribeInstanceTypesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstanceTypesOutput, error)
+}
+
+// Client bundles the AWS service clients used by this extension. Only the parts relevant to
+// bastion handling are modeled here.
+type Client struct {
+	Region string
+	EC2    Interface
+}
+
+// Factory creates a region/credential-specific Client for a shoot, resolving the credentials
+// from the SecretBinding referenced by the given namespace/secretBindingName.
+type Factory interface {
+	NewClient(ctx context.Context, k8sClient client.Client, namespace, secretBindingName, region string) (*Client, error)
+}
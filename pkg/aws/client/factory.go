@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	extensionsconfig "github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
+)
+
+const (
+	// AccessKeyID is the data key for the AWS access key ID in a cloud provider Secret.
+	AccessKeyID = "accessKeyID"
+	// SecretAccessKey is the data key for the AWS secret access key in a cloud provider Secret.
+	SecretAccessKey = "secretAccessKey"
+)
+
+// DefaultFactory is the production Factory implementation. Every Client it creates for a given
+// region and access key shares a single CachingInterface, so that repeated calls for that region (e.g. across
+// multiple Bastions in the same shoot, or repeated DetermineOptions invocations during
+// reconciliation) share cached describe results instead of re-issuing identical EC2 calls.
+type DefaultFactory struct {
+	// cacheTTL is the TTL passed to each CachingInterface.
+	cacheTTL time.Duration
+
+	mu      sync.Mutex
+	regions map[string]*CachingInterface
+}
+
+// NewDefaultFactory creates a new DefaultFactory, sourcing the describe-call cache TTL from the
+// given BastionConfig (falling back to DefaultCacheTTL if unset).
+func NewDefaultFactory(cfg extensionsconfig.BastionConfig) *DefaultFactory {
+	ttl := DefaultCacheTTL
+	if cfg.EC2DescribeCacheTTL != nil {
+		ttl = cfg.EC2DescribeCacheTTL.Duration
+	}
+
+	return &DefaultFactory{
+		cacheTTL: ttl,
+		regions:  map[string]*CachingInterface{},
+	}
+}
+
+// NewClient implements Factory. It resolves AWS credentials from the SecretBinding named
+// secretBindingName in namespace and returns a Client whose EC2 service shares this Factory's
+// describe-call cache for the region and access key. Only SecretBinding objects are looked up; a
+// CredentialsBinding name passed as secretBindingName is not resolved and results in an error.
+func (f *DefaultFactory) NewClient(ctx context.Context, k8sClient client.Client, namespace, secretBindingName, region string) (*Client, error) {
+	accessKeyID, secretAccessKey, err := resolveCredentials(ctx, k8sClient, namespace, secretBindingName)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := awsconfig.LoadDefaultConfig(ctx,
+		awsconfig.WithRegion(region),
+		awsconfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(accessKeyID, secretAccessKey, "")),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+
+	return &Client{
+		Region: region,
+		EC2:    f.cachingInterfaceForRegion(region, accessKeyID, ec2.NewFromConfig(cfg)),
+	}, nil
+}
+
+// cachingInterfaceForRegion returns the CachingInterface shared by all Clients this Factory has
+// created for the given region and access key, creating one backed by delegate on the first
+// call. Clients with different credentials never share a delegate or cached results.
+func (f *DefaultFactory) cachingInterfaceForRegion(region, accessKeyID string, delegate Interface) *CachingInterface {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := region + "|" + accessKeyID
+	if existing, ok := f.regions[key]; ok {
+		return existing
+	}
+
+	caching := NewCachingInterface(delegate, region, f.cacheTTL)
+	f.regions[key] = caching
+	return caching
+}
+
+// resolveCredentials looks up the SecretBinding named secretBindingName and returns the AWS
+// access key pair from the Secret it references.
+func resolveCredentials(ctx context.Context, k8sClient client.Client, namespace, secretBindingName string) (accessKeyID, secretAccessKey string, err error) {
+	secretBinding := &gardencorev1beta1.SecretBinding{}
+	if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: secretBindingName}, secretBinding); err != nil {
+		return "", "", fmt.Errorf("failed to get secret binding %q: %w", secretBindingName, err)
+	}
+
+	secretNamespace := secretBinding.SecretRef.Namespace
+	if secretNamespace == "" {
+		secretNamespace = namespace
+	}
+
+	secret := &corev1.Secret{}
+	if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: secretNamespace, Name: secretBinding.SecretRef.Name}, secret); err != nil {
+		return "", "", fmt.Errorf("failed to get secret %q: %w", secretBinding.SecretRef.Name, err)
+	}
+
+	accessKeyID = string(secret.Data[AccessKeyID])
+	secretAccessKey = string(secret.Data[SecretAccessKey])
+	if accessKeyID == "" || secretAccessKey == "" {
+		return "", "", fmt.Errorf("secret %q does not contain both %q and %q", secretBinding.SecretRef.Name, AccessKeyID, SecretAccessKey)
+	}
+
+	return accessKeyID, secretAccessKey, nil
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsbastion "github.com/gardener/gardener/extensions/pkg/controller/bastion"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// actuator handles Bastion resources on AWS, using DetermineOptions to resolve the VPC/subnet,
+// image and instance type to use. It does not create or delete any EC2 resources.
+type actuator struct {
+	client           client.Client
+	awsClientFactory awsclient.Factory
+}
+
+// NewActuator creates a new extensionsbastion.Actuator for the AWS provider.
+func NewActuator(mgr manager.Manager, awsClientFactory awsclient.Factory) extensionsbastion.Actuator {
+	return &actuator{
+		client:           mgr.GetClient(),
+		awsClientFactory: awsClientFactory,
+	}
+}
+
+// Reconcile determines the bastion's options and logs them. It does not create the bastion
+// security group or EC2 instance described by them.
+func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *extensionsv1alpha1.Bastion, cluster *controller.Cluster) error {
+	opt, err := a.determineOptions(ctx, bastion, cluster)
+	if err != nil {
+		return err
+	}
+
+	// TODO: ensure the bastion security group (opt.BastionSecurityGroupName) and the EC2
+	// instance (opt.InstanceType/opt.ImageID) described by opt exist, and publish the bastion's
+	// ingress address to bastion.Status. This resource provisioning is tracked separately.
+	log.Info("determined bastion options", "subnetID", opt.SubnetID, "vpcID", opt.VPCID, "zone", opt.Zone, "instanceType", opt.InstanceType, "imageID", opt.ImageID)
+
+	return nil
+}
+
+// Delete returns nil without deleting any cloud resources.
+func (a *actuator) Delete(_ context.Context, _ logr.Logger, _ *extensionsv1alpha1.Bastion, _ *controller.Cluster) error {
+	// TODO: tear down the bastion security group and EC2 instance. This resource teardown is
+	// tracked separately.
+	return nil
+}
+
+// ForceDelete delegates to Delete.
+func (a *actuator) ForceDelete(ctx context.Context, log logr.Logger, bastion *extensionsv1alpha1.Bastion, cluster *controller.Cluster) error {
+	return a.Delete(ctx, log, bastion, cluster)
+}
+
+// determineOptions creates an AWS client for the shoot and resolves the bastion's Options.
+func (a *actuator) determineOptions(ctx context.Context, bastion *extensionsv1alpha1.Bastion, cluster *controller.Cluster) (*Options, error) {
+	secretBindingName, err := ResolveSecretBindingName(cluster.Shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	awsClient, err := a.awsClientFactory.NewClient(ctx, a.client, bastion.Namespace, secretBindingName, cluster.Shoot.Spec.Region)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AWS client: %w", err)
+	}
+
+	opt, err := DetermineOptions(ctx, a.client, bastion, cluster, awsClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine bastion options: %w", err)
+	}
+
+	return opt, nil
+}
@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	awsv1alpha1 "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// defaultInstanceTypeCandidates are the ordered, deterministic candidate instance types tried
+// for a bastion host before falling back to scanning all "t*" offerings. Candidates are ordered
+// smallest-first so the cheapest suitable type is always preferred.
+var defaultInstanceTypeCandidates = map[ec2types.ArchitectureValues][]string{
+	ec2types.ArchitectureValuesArm64: {"t4g.nano", "t4g.micro", "t4g.small"},
+	ec2types.ArchitectureValuesX8664: {"t3a.nano", "t3.nano", "t3a.micro", "t3.micro"},
+}
+
+// determineInstanceType picks the instance type to use for the bastion host. It prefers the
+// bastion-scoped machine type configured via CloudProfileConfig.Bastion (with per-region
+// overrides). Otherwise it tries an ordered list of candidate instance types for the image's
+// architecture (configurable via CloudProfileConfig.Bastion.InstanceTypeCandidates), and only
+// after exhausting that list falls back to scanning all "t*" offerings in the given zone,
+// picking the smallest one by vCPU/memory.
+func determineInstanceType(ctx context.Context, shoot *gardencorev1beta1.Shoot, providerConfig *awsv1alpha1.CloudProfileConfig, imageID, zone string, awsClient *awsclient.Client) (string, error) {
+	imageInfo, err := GetImages(ctx, imageID, awsClient)
+	if err != nil {
+		return "", err
+	}
+
+	imageArchitecture := imageInfo.Architecture
+
+	if providerConfig.Bastion != nil && providerConfig.Bastion.MachineType != nil {
+		return determineBastionMachineType(shoot, *providerConfig.Bastion.MachineType), nil
+	}
+
+	for _, candidate := range instanceTypeCandidates(providerConfig, imageArchitecture) {
+		offerings, err := getInstanceTypeOfferingsInZone(ctx, candidate, zone, awsClient)
+		if err != nil {
+			return "", err
+		}
+		if len(offerings.InstanceTypeOfferings) != 0 {
+			return candidate, nil
+		}
+	}
+
+	return determineSmallestOfferedInstanceType(ctx, imageArchitecture, zone, awsClient)
+}
+
+// determineBastionMachineType resolves the configured instance type for the shoot's region,
+// preferring a per-region override over the top-level default.
+func determineBastionMachineType(shoot *gardencorev1beta1.Shoot, machineType awsv1alpha1.BastionMachineType) string {
+	for _, region := range machineType.Regions {
+		if region.Name == shoot.Spec.Region {
+			return region.MachineType
+		}
+	}
+
+	return machineType.Name
+}
+
+// instanceTypeCandidates returns the ordered list of instance types to try for the given
+// architecture, preferring an operator-configured list over the built-in defaults.
+func instanceTypeCandidates(providerConfig *awsv1alpha1.CloudProfileConfig, architecture ec2types.ArchitectureValues) []string {
+	if providerConfig.Bastion != nil {
+		for _, candidates := range providerConfig.Bastion.InstanceTypeCandidates {
+			if candidates.Architecture == string(architecture) {
+				return candidates.InstanceTypes
+			}
+		}
+	}
+
+	return defaultInstanceTypeCandidates[architecture]
+}
+
+// determineSmallestOfferedInstanceType scans all "t*" instance type offerings available in the
+// given zone and deterministically picks the one with the fewest vCPUs (ties broken by memory
+// size), rather than relying on unordered API response order.
+func determineSmallestOfferedInstanceType(ctx context.Context, architecture ec2types.ArchitectureValues, zone string, awsClient *awsclient.Client) (string, error) {
+	tTypes, err := getInstanceTypeOfferingsInZone(ctx, "t*", zone, awsClient)
+	if err != nil {
+		return "", err
+	}
+
+	if len(tTypes.InstanceTypeOfferings) == 0 {
+		return "", fmt.Errorf("no t* instance type offerings available in zone %q", zone)
+	}
+
+	tTypeSet := sets.Set[ec2types.InstanceType]{}
+	for _, t := range tTypes.InstanceTypeOfferings {
+		tTypeSet.Insert(t.InstanceType)
+	}
+
+	result, err := awsClient.EC2.DescribeInstanceTypes(ctx, &ec2.DescribeInstanceTypesInput{
+		InstanceTypes: tTypeSet.UnsortedList(),
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("processor-info.supported-architecture"),
+				Values: []string{string(architecture)},
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(result.InstanceTypes) == 0 {
+		return "", fmt.Errorf("no instance types returned for architecture %s and instance types list %v", architecture, tTypeSet.UnsortedList())
+	}
+
+	sort.Slice(result.InstanceTypes, func(i, j int) bool {
+		a, b := result.InstanceTypes[i], result.InstanceTypes[j]
+		if a.VCpuInfo.DefaultVCpus == nil || b.VCpuInfo.DefaultVCpus == nil {
+			return false
+		}
+		if *a.VCpuInfo.DefaultVCpus != *b.VCpuInfo.DefaultVCpus {
+			return *a.VCpuInfo.DefaultVCpus < *b.VCpuInfo.DefaultVCpus
+		}
+		if a.MemoryInfo.SizeInMiB == nil || b.MemoryInfo.SizeInMiB == nil {
+			return false
+		}
+		return *a.MemoryInfo.SizeInMiB < *b.MemoryInfo.SizeInMiB
+	})
+
+	return string(result.InstanceTypes[0].InstanceType), nil
+}
+
+// getInstanceTypeOfferingsInZone looks up instance type offerings matching the given
+// instance-type filter that are actually offered in the given availability zone, so the
+// selected type is guaranteed to be usable in the subnet the bastion is placed in.
+func getInstanceTypeOfferingsInZone(ctx context.Context, filter, zone string, awsClient *awsclient.Client) (*ec2.DescribeInstanceTypeOfferingsOutput, error) {
+	filters := []ec2types.Filter{
+		{
+			Name:   aws.String("instance-type"),
+			Values: []string{filter},
+		},
+	}
+
+	if zone != "" {
+		filters = append(filters, ec2types.Filter{
+			Name:   aws.String("location"),
+			Values: []string{zone},
+		})
+	}
+
+	return awsClient.EC2.DescribeInstanceTypeOfferings(ctx, &ec2.DescribeInstanceTypeOfferingsInput{
+		LocationType: ec2types.LocationTypeAvailabilityZone,
+		Filters:      filters,
+	})
+}
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion
+
+import (
+	"testing"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	awsv1alpha1 "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+)
+
+func shootInRegion(region string) *gardencorev1beta1.Shoot {
+	return &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Region: region}}
+}
+
+func TestBastion(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Bastion Controller Suite")
+}
+
+var _ = Describe("instanceTypeCandidates", func() {
+	It("returns the built-in defaults when no CloudProfileConfig override is set", func() {
+		Expect(instanceTypeCandidates(&awsv1alpha1.CloudProfileConfig{}, ec2types.ArchitectureValuesArm64)).
+			To(Equal(defaultInstanceTypeCandidates[ec2types.ArchitectureValuesArm64]))
+	})
+
+	It("prefers the operator-configured candidate list for the matching architecture", func() {
+		providerConfig := &awsv1alpha1.CloudProfileConfig{
+			Bastion: &awsv1alpha1.Bastion{
+				InstanceTypeCandidates: []awsv1alpha1.InstanceTypeCandidates{
+					{Architecture: "arm64", InstanceTypes: []string{"t4g.micro"}},
+					{Architecture: "x86_64", InstanceTypes: []string{"t3.micro"}},
+				},
+			},
+		}
+
+		Expect(instanceTypeCandidates(providerConfig, ec2types.ArchitectureValuesArm64)).To(Equal([]string{"t4g.micro"}))
+		Expect(instanceTypeCandidates(providerConfig, ec2types.ArchitectureValuesX8664)).To(Equal([]string{"t3.micro"}))
+	})
+})
+
+var _ = Describe("determineBastionMachineType", func() {
+	It("prefers the per-region override over the top-level default", func() {
+		machineType := awsv1alpha1.BastionMachineType{
+			Name: "t4g.nano",
+			Regions: []awsv1alpha1.RegionMachineTypeMapping{
+				{Name: "eu-central-1", MachineType: "t4g.micro"},
+			},
+		}
+
+		Expect(determineBastionMachineType(shootInRegion("eu-central-1"), machineType)).To(Equal("t4g.micro"))
+		Expect(determineBastionMachineType(shootInRegion("eu-west-1"), machineType)).To(Equal("t4g.nano"))
+	})
+})
@@ -8,6 +8,8 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -17,7 +19,9 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/extensions"
-	"k8s.io/apimachinery/pkg/util/sets"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	awsv1alpha1 "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
 	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
@@ -31,6 +35,7 @@ type Options struct {
 	Shoot                    *gardencorev1beta1.Shoot
 	SubnetID                 string
 	VPCID                    string
+	Zone                     string
 	BastionSecurityGroupName string
 	WorkerSecurityGroupName  string
 	WorkerSecurityGroupID    string
@@ -45,17 +50,16 @@ type Options struct {
 // DetermineOptions determines the required information like VPC ID and
 // instance type that are required to reconcile a Bastion on AWS. This
 // function does not create any IaaS resources.
-func DetermineOptions(ctx context.Context, bastion *extensionsv1alpha1.Bastion, cluster *controller.Cluster, awsClient *awsclient.Client) (*Options, error) {
+func DetermineOptions(ctx context.Context, c client.Client, bastion *extensionsv1alpha1.Bastion, cluster *controller.Cluster, awsClient *awsclient.Client) (*Options, error) {
 	name := cluster.ObjectMeta.Name
-	subnetName := name + "-public-utility-z0"
 	instanceName := fmt.Sprintf("%s-%s-bastion", name, bastion.Name)
 
 	// this security group will be created during reconciliation
 	bastionSecurityGroupName := fmt.Sprintf("%s-%s-bsg", name, bastion.Name)
 
-	subnetID, vpcID, err := resolveSubnetName(ctx, awsClient, subnetName)
+	subnetID, vpcID, zone, err := determineVPCAndSubnet(ctx, c, awsClient, bastion.Namespace, name, preferredZone(cluster.Shoot))
 	if err != nil {
-		return nil, fmt.Errorf("failed to find subnet %q: %w", subnetName, err)
+		return nil, err
 	}
 
 	// this security group exists already and just needs to be resolved to its ID
@@ -68,17 +72,17 @@ func DetermineOptions(ctx context.Context, bastion *extensionsv1alpha1.Bastion,
 		return nil, fmt.Errorf("security group for worker node does not exist yet")
 	}
 
-	cloudProfileConfig, err := getCloudProfileConfig(cluster)
+	cloudProfileConfig, err := GetCloudProfileConfig(cluster)
 	if err != nil {
 		return nil, fmt.Errorf("failed to extract cloud provider config from cluster: %w", err)
 	}
 
-	imageID, err := determineImageID(cluster.Shoot, cloudProfileConfig)
+	imageID, err := DetermineImageID(ctx, awsClient, cluster.Shoot, cloudProfileConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to determine OS image for bastion host: %w", err)
 	}
 
-	instanceType, err := determineInstanceType(ctx, imageID, awsClient)
+	instanceType, err := determineInstanceType(ctx, cluster.Shoot, cloudProfileConfig, imageID, zone, awsClient)
 	if err != nil {
 		return nil, fmt.Errorf("failed to determine instance type: %w", err)
 	}
@@ -87,6 +91,7 @@ func DetermineOptions(ctx context.Context, bastion *extensionsv1alpha1.Bastion,
 		Shoot:                    cluster.Shoot,
 		SubnetID:                 subnetID,
 		VPCID:                    vpcID,
+		Zone:                     zone,
 		BastionSecurityGroupName: bastionSecurityGroupName,
 		WorkerSecurityGroupName:  workerSecurityGroupName,
 		WorkerSecurityGroupID:    *workerSecurityGroup.GroupId,
@@ -96,9 +101,104 @@ func DetermineOptions(ctx context.Context, bastion *extensionsv1alpha1.Bastion,
 	}, nil
 }
 
-// resolveSubnetName resolves a subnet name to its ID and the VPC ID. If no subnet with the
+// determineVPCAndSubnet resolves the VPC ID, the public utility subnet ID and the zone the
+// bastion instance should be placed in. It prefers reading this information from the shoot's
+// InfrastructureStatus (as the Azure provider does), which also covers BYO VPC setups and
+// custom subnet naming, and only falls back to the legacy tag-based subnet lookup when no
+// InfrastructureStatus is available yet, e.g. because the Infrastructure resource has not been
+// reconciled.
+func determineVPCAndSubnet(ctx context.Context, c client.Client, awsClient *awsclient.Client, namespace, name, preferredZone string) (subnetID, vpcID, zone string, err error) {
+	infrastructureStatus, err := getInfrastructureStatus(ctx, c, namespace, name)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to get infrastructure status: %w", err)
+	}
+
+	if infrastructureStatus != nil && infrastructureStatus.VPC.ID != nil {
+		subnet, ok := findPublicUtilitySubnet(infrastructureStatus.VPC.Subnets, preferredZone)
+		if ok {
+			return subnet.ID, *infrastructureStatus.VPC.ID, subnet.Zone, nil
+		}
+	}
+
+	// fall back to the tag-based lookup for shoots without an InfrastructureStatus
+	subnetName := name + "-public-utility-z0"
+	subnetID, vpcID, zone, err = resolveSubnetName(ctx, awsClient, subnetName)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to find subnet %q: %w", subnetName, err)
+	}
+
+	return subnetID, vpcID, zone, nil
+}
+
+// findPublicUtilitySubnet picks the public utility subnet from the given list of subnets,
+// preferring one in preferredZone (typically a zone already used by the shoot's worker pools)
+// so the bastion lands next to the nodes it is meant to reach. If preferredZone is empty or no
+// public subnet exists there, the first public-purpose subnet (in the order reported by the
+// infrastructure status) is used instead.
+func findPublicUtilitySubnet(subnets []awsv1alpha1.Subnet, preferredZone string) (awsv1alpha1.Subnet, bool) {
+	var fallback *awsv1alpha1.Subnet
+
+	for i, subnet := range subnets {
+		if subnet.Purpose != awsv1alpha1.PurposePublic {
+			continue
+		}
+		if fallback == nil {
+			fallback = &subnets[i]
+		}
+		if preferredZone != "" && subnet.Zone == preferredZone {
+			return subnet, true
+		}
+	}
+
+	if fallback != nil {
+		return *fallback, true
+	}
+
+	return awsv1alpha1.Subnet{}, false
+}
+
+// preferredZone returns the zone the bastion should preferably land in, derived from the
+// shoot's first worker pool zone so the bastion is network-adjacent to the nodes it is used to
+// reach. It returns the empty string if the shoot has no zoned worker pools yet.
+func preferredZone(shoot *gardencorev1beta1.Shoot) string {
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if len(worker.Zones) > 0 {
+			return worker.Zones[0]
+		}
+	}
+
+	return ""
+}
+
+// getInfrastructureStatus reads and decodes the ProviderStatus of the Infrastructure resource
+// belonging to the shoot. It returns nil if the Infrastructure resource does not exist yet or
+// has not reported a status, so callers can fall back to other means of determining the VPC.
+func getInfrastructureStatus(ctx context.Context, c client.Client, namespace, name string) (*awsv1alpha1.InfrastructureStatus, error) {
+	infrastructure := &extensionsv1alpha1.Infrastructure{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, infrastructure); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if infrastructure.Status.ProviderStatus == nil {
+		return nil, nil
+	}
+
+	infrastructureStatus := &awsv1alpha1.InfrastructureStatus{}
+	decoder := kubernetes.GardenCodec.UniversalDeserializer()
+	if _, _, err := decoder.Decode(infrastructure.Status.ProviderStatus.Raw, nil, infrastructureStatus); err != nil {
+		return nil, err
+	}
+
+	return infrastructureStatus, nil
+}
+
+// resolveSubnetName resolves a subnet name to its ID, VPC ID and zone using a tag:Name filter.
+// This is used as a fallback when no InfrastructureStatus is available. If no subnet with the
 // given name exists, an error is returned.
-func resolveSubnetName(ctx context.Context, awsClient *awsclient.Client, subnetName string) (subnetID string, vpcID string, err error) {
+func resolveSubnetName(ctx context.Context, awsClient *awsclient.Client, subnetName string) (subnetID string, vpcID string, zone string, err error) {
 	subnets, err := awsClient.EC2.DescribeSubnets(ctx, &ec2.DescribeSubnetsInput{
 		Filters: []ec2types.Filter{
 			{
@@ -118,11 +218,53 @@ func resolveSubnetName(ctx context.Context, awsClient *awsclient.Client, subnetN
 
 	subnetID = *subnets.Subnets[0].SubnetId
 	vpcID = *subnets.Subnets[0].VpcId
+	if subnets.Subnets[0].AvailabilityZone != nil {
+		zone = *subnets.Subnets[0].AvailabilityZone
+	}
 
 	return
 }
 
-func getCloudProfileConfig(cluster *extensions.Cluster) (*awsv1alpha1.CloudProfileConfig, error) {
+// getSecurityGroup looks up the security group with the given name in the given VPC via its
+// tag:Name, returning nil (without error) if no such security group exists yet.
+func getSecurityGroup(ctx context.Context, awsClient *awsclient.Client, vpcID, name string) (*ec2types.SecurityGroup, error) {
+	out, err := awsClient.EC2.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
+		Filters: []ec2types.Filter{
+			{Name: aws.String("vpc-id"), Values: []string{vpcID}},
+			{Name: aws.String("tag:Name"), Values: []string{name}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out.SecurityGroups) == 0 {
+		return nil, nil
+	}
+
+	return &out.SecurityGroups[0], nil
+}
+
+// ResolveSecretBindingName returns the name of the binding that should be used to resolve cloud
+// provider credentials for the given shoot. SecretBindingName is preferred for backwards
+// compatibility; CredentialsBindingName is used if it is the only one set. It is exported so
+// that both the bastion actuator and admission validator resolve credentials the same way.
+func ResolveSecretBindingName(shoot *gardencorev1beta1.Shoot) (string, error) {
+	if shoot.Spec.SecretBindingName != nil && *shoot.Spec.SecretBindingName != "" {
+		return *shoot.Spec.SecretBindingName, nil
+	}
+
+	if shoot.Spec.CredentialsBindingName != nil && *shoot.Spec.CredentialsBindingName != "" {
+		return *shoot.Spec.CredentialsBindingName, nil
+	}
+
+	return "", fmt.Errorf("shoot %q specifies neither a secretBindingName nor a credentialsBindingName", shoot.Name)
+}
+
+// GetCloudProfileConfig decodes the AWS-specific CloudProfileConfig from the cluster's
+// CloudProfile and applies its defaults. It is exported so that the bastion admission validator
+// can reuse the same decoding logic used during reconciliation.
+func GetCloudProfileConfig(cluster *extensions.Cluster) (*awsv1alpha1.CloudProfileConfig, error) {
 	if cluster.CloudProfile.Spec.ProviderConfig.Raw == nil {
 		return nil, fmt.Errorf("no cloud provider config set in cluster's CloudProfile")
 	}
@@ -136,12 +278,22 @@ func getCloudProfileConfig(cluster *extensions.Cluster) (*awsv1alpha1.CloudProfi
 		return nil, err
 	}
 
+	// the garden scheme's UniversalDeserializer does not know about this provider's defaulters,
+	// so they are applied explicitly here rather than relying on defaulter registration.
+	awsv1alpha1.SetDefaults_CloudProfileConfig(cloudProfileConfig)
+
 	return cloudProfileConfig, nil
 }
 
-// determineImageID finds the first AMI that is configured for the same region as the shoot cluster.
-// If no image is found, an error is returned.
-func determineImageID(shoot *gardencorev1beta1.Shoot, providerConfig *awsv1alpha1.CloudProfileConfig) (string, error) {
+// DetermineImageID finds the AMI that should be used for the bastion host in the shoot's
+// region. It prefers the bastion-scoped image configured via CloudProfileConfig.Bastion, if
+// present, and falls back to the legacy convention of picking a gardenlinux 1312.x.x image from
+// MachineImages otherwise. If no image is found, an error is returned.
+func DetermineImageID(ctx context.Context, awsClient *awsclient.Client, shoot *gardencorev1beta1.Shoot, providerConfig *awsv1alpha1.CloudProfileConfig) (string, error) {
+	if providerConfig.Bastion != nil {
+		return determineBastionImageID(ctx, awsClient, shoot, providerConfig, providerConfig.Bastion.MachineImage)
+	}
+
 	// TODO(hebelsan): remove version hack after bastion image is well defined, e.g. in cloudProfile
 	// only allow garden linux versions 1312.x.x because they have ssh enabled by default
 	re := regexp.MustCompile(`^1312\.\d+\.\d+$`)
@@ -161,71 +313,132 @@ func determineImageID(shoot *gardencorev1beta1.Shoot, providerConfig *awsv1alpha
 	return "", fmt.Errorf("found no suitable AMI for machines in region %q", shoot.Spec.Region)
 }
 
-func determineInstanceType(ctx context.Context, imageID string, awsClient *awsclient.Client) (string, error) {
-	var preferredType string
-	imageInfo, err := getImages(ctx, imageID, awsClient)
-	if err != nil {
-		return "", err
+// determineBastionImageID resolves the AMI for the given bastion machine image. The AMISelector
+// (if set) takes precedence, as it allows operators to pin bastion AMIs by EC2 image tags
+// without shipping a specific hardcoded version; otherwise explicit per-region overrides are
+// preferred over the generic name/version lookup, which falls back to looking the image up by
+// Name/Version (defaulting to the highest available version) in the CloudProfileConfig's
+// MachineImages section.
+func determineBastionImageID(ctx context.Context, awsClient *awsclient.Client, shoot *gardencorev1beta1.Shoot, providerConfig *awsv1alpha1.CloudProfileConfig, image awsv1alpha1.BastionMachineImage) (string, error) {
+	if len(image.AMISelector) > 0 {
+		return resolveAMIBySelector(ctx, awsClient, image.AMISelector)
 	}
 
-	imageArchitecture := imageInfo.Architecture
-
-	// default instance type
-	switch imageArchitecture {
-	case ec2types.ArchitectureValuesX8664:
-		preferredType = "t2.nano"
-	case ec2types.ArchitectureValuesArm64:
-		preferredType = "t4g.nano"
-	default:
-		return "", fmt.Errorf("image architecture not supported")
+	for _, region := range image.Regions {
+		if region.Name == shoot.Spec.Region {
+			return region.AMI, nil
+		}
 	}
 
-	exist, err := getInstanceTypeOfferings(ctx, preferredType, awsClient)
-	if err != nil {
-		return "", err
-	}
+	return determineBastionImageIDFromMachineImages(providerConfig.MachineImages, shoot.Spec.Region, image)
+}
+
+// determineBastionImageIDFromMachineImages looks up the bastion image's AMI in the
+// CloudProfileConfig's MachineImages section by Name/Version, as documented on
+// BastionMachineImage.Regions. If image.Version is unset, the highest available version of the
+// named machine image is used instead, as documented on BastionMachineImage.Version.
+func determineBastionImageIDFromMachineImages(machineImages []awsv1alpha1.MachineImages, region string, image awsv1alpha1.BastionMachineImage) (string, error) {
+	for _, machineImage := range machineImages {
+		if machineImage.Name != image.Name {
+			continue
+		}
+
+		version := image.Version
+		if version == nil {
+			version = highestMachineImageVersion(machineImage.Versions)
+		}
+		if version == nil {
+			continue
+		}
 
-	if len(exist.InstanceTypeOfferings) != 0 {
-		return preferredType, nil
+		for _, v := range machineImage.Versions {
+			if v.Version != *version {
+				continue
+			}
+			for _, r := range v.Regions {
+				if r.Name == region {
+					return r.AMI, nil
+				}
+			}
+		}
 	}
 
-	// filter t type instance
-	tTypes, err := getInstanceTypeOfferings(ctx, "t*", awsClient)
-	if err != nil {
-		return "", err
+	if image.Version != nil {
+		return "", fmt.Errorf("no bastion AMI configured for machine image %q version %q in region %q", image.Name, *image.Version, region)
 	}
+	return "", fmt.Errorf("no bastion AMI configured for machine image %q in region %q", image.Name, region)
+}
 
-	if len(tTypes.InstanceTypeOfferings) == 0 {
-		return "", fmt.Errorf("no t* instance type offerings available")
+// highestMachineImageVersion returns the highest of the given versions, or nil if versions is
+// empty.
+func highestMachineImageVersion(versions []awsv1alpha1.MachineImageVersion) *string {
+	var highest *string
+	for i := range versions {
+		if highest == nil || compareDottedVersions(versions[i].Version, *highest) > 0 {
+			highest = &versions[i].Version
+		}
 	}
+	return highest
+}
 
-	tTypeSet := sets.Set[ec2types.InstanceType]{}
-	for _, t := range tTypes.InstanceTypeOfferings {
-		tTypeSet.Insert(t.InstanceType)
+// compareDottedVersions compares two dot-separated numeric versions (e.g. "1312.3.0"),
+// returning a positive number if a > b, a negative number if a < b, and 0 if they are equal.
+// Non-numeric or missing segments are treated as 0.
+func compareDottedVersions(a, b string) int {
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var an, bn int
+		if i < len(as) {
+			an, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			bn, _ = strconv.Atoi(bs[i])
+		}
+		if diff := an - bn; diff != 0 {
+			return diff
+		}
 	}
 
-	result, err := awsClient.EC2.DescribeInstanceTypes(ctx, &ec2.DescribeInstanceTypesInput{
-		InstanceTypes: tTypeSet.UnsortedList(),
-		Filters: []ec2types.Filter{
-			{
-				Name:   aws.String("processor-info.supported-architecture"),
-				Values: []string{string(imageArchitecture)},
-			},
-		},
-	})
+	return 0
+}
 
+// resolveAMIBySelector looks up the newest AMI matching the given tag selector, analogous to
+// Karpenter's AMISelector. It lets clusters run bastions without shipping a specific hardcoded
+// AMI or gardenlinux version.
+func resolveAMIBySelector(ctx context.Context, awsClient *awsclient.Client, selector map[string]string) (string, error) {
+	filters := make([]ec2types.Filter, 0, len(selector))
+	for tag, value := range selector {
+		filters = append(filters, ec2types.Filter{
+			Name:   aws.String("tag:" + tag),
+			Values: []string{value},
+		})
+	}
+
+	images, err := awsClient.EC2.DescribeImages(ctx, &ec2.DescribeImagesInput{Filters: filters})
 	if err != nil {
-		return "", err
+		return "", fmt.Errorf("failed to resolve bastion AMI by selector %v: %w", selector, err)
+	}
+
+	if len(images.Images) == 0 {
+		return "", fmt.Errorf("no AMI found matching selector %v", selector)
 	}
 
-	if len(result.InstanceTypes) == 0 {
-		return "", fmt.Errorf("no instance types returned for architecture %s and instance types list %v", imageArchitecture, tTypeSet.UnsortedList())
+	newest := images.Images[0]
+	for _, image := range images.Images[1:] {
+		if image.CreationDate != nil && (newest.CreationDate == nil || *image.CreationDate > *newest.CreationDate) {
+			newest = image
+		}
 	}
 
-	return string(result.InstanceTypes[0].InstanceType), nil
+	return *newest.ImageId, nil
 }
 
-func getImages(ctx context.Context, ami string, awsClient *awsclient.Client) (*ec2types.Image, error) {
+// GetImages looks up the EC2 image (AMI) with the given ID and returns it. It is exported so
+// that the bastion admission validator can pre-flight the same AMI lookup that is performed
+// during reconciliation.
+func GetImages(ctx context.Context, ami string, awsClient *awsclient.Client) (*ec2types.Image, error) {
 	imageInfo, err := awsClient.EC2.DescribeImages(ctx, &ec2.DescribeImagesInput{
 		ImageIds: []string{ami},
 	})
@@ -239,14 +452,3 @@ func getImages(ctx context.Context, ami string, awsClient *awsclient.Client) (*e
 	}
 	return &imageInfo.Images[0], nil
 }
-
-func getInstanceTypeOfferings(ctx context.Context, filter string, awsClient *awsclient.Client) (*ec2.DescribeInstanceTypeOfferingsOutput, error) {
-	return awsClient.EC2.DescribeInstanceTypeOfferings(ctx, &ec2.DescribeInstanceTypeOfferingsInput{
-		Filters: []ec2types.Filter{
-			{
-				Name:   aws.String("instance-type"),
-				Values: []string{filter},
-			},
-		},
-	})
-}
@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	awsv1alpha1 "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// countingEC2 is a minimal hand-rolled fake implementing awsclient.Interface that counts how
+// often each describe operation is actually invoked, so tests can assert on cache sharing.
+type countingEC2 struct {
+	describeSecurityGroupsCalls        int
+	describeImagesCalls                int
+	describeInstanceTypeOfferingsCalls int
+}
+
+func (f *countingEC2) DescribeSubnets(context.Context, *ec2.DescribeSubnetsInput, ...func(*ec2.Options)) (*ec2.DescribeSubnetsOutput, error) {
+	return &ec2.DescribeSubnetsOutput{}, nil
+}
+
+func (f *countingEC2) DescribeSecurityGroups(context.Context, *ec2.DescribeSecurityGroupsInput, ...func(*ec2.Options)) (*ec2.DescribeSecurityGroupsOutput, error) {
+	f.describeSecurityGroupsCalls++
+	return &ec2.DescribeSecurityGroupsOutput{
+		SecurityGroups: []ec2types.SecurityGroup{{GroupId: aws.String("sg-1")}},
+	}, nil
+}
+
+func (f *countingEC2) DescribeImages(context.Context, *ec2.DescribeImagesInput, ...func(*ec2.Options)) (*ec2.DescribeImagesOutput, error) {
+	f.describeImagesCalls++
+	return &ec2.DescribeImagesOutput{
+		Images: []ec2types.Image{{Architecture: ec2types.ArchitectureValuesArm64}},
+	}, nil
+}
+
+func (f *countingEC2) DescribeInstanceTypeOfferings(context.Context, *ec2.DescribeInstanceTypeOfferingsInput, ...func(*ec2.Options)) (*ec2.DescribeInstanceTypeOfferingsOutput, error) {
+	f.describeInstanceTypeOfferingsCalls++
+	return &ec2.DescribeInstanceTypeOfferingsOutput{
+		InstanceTypeOfferings: []ec2types.InstanceTypeOffering{{InstanceType: ec2types.InstanceTypeT4gNano}},
+	}, nil
+}
+
+func (f *countingEC2) DescribeInstanceTypes(context.Context, *ec2.DescribeInstanceTypesInput, ...func(*ec2.Options)) (*ec2.DescribeInstanceTypesOutput, error) {
+	return &ec2.DescribeInstanceTypesOutput{}, nil
+}
+
+var _ = Describe("DetermineOptions", func() {
+	const (
+		namespace = "shoot--foo--bar"
+		name      = "shoot--foo--bar"
+		region    = "eu-west-1"
+		zone      = "eu-west-1a"
+	)
+
+	It("shares cached EC2 describe results across repeated invocations for the same shoot, as the Factory cache does in production", func() {
+		infrastructureStatus := &awsv1alpha1.InfrastructureStatus{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: awsv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "InfrastructureStatus",
+			},
+			VPC: awsv1alpha1.VPCStatus{
+				ID: aws.String("vpc-1"),
+				Subnets: []awsv1alpha1.Subnet{
+					{ID: "subnet-1", Purpose: awsv1alpha1.PurposePublic, Zone: zone},
+				},
+			},
+		}
+		infrastructure := &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Status: extensionsv1alpha1.InfrastructureStatus{
+				DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					ProviderStatus: &runtime.RawExtension{Raw: mustMarshalJSON(infrastructureStatus)},
+				},
+			},
+		}
+		c := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(infrastructure).Build()
+
+		cloudProfileConfig := &awsv1alpha1.CloudProfileConfig{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: awsv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "CloudProfileConfig",
+			},
+			MachineImages: []awsv1alpha1.MachineImages{{
+				Name: "gardenlinux",
+				Versions: []awsv1alpha1.MachineImageVersion{{
+					Version: "1312.3.0",
+					Regions: []awsv1alpha1.RegionAMIMapping{{Name: region, AMI: "ami-1234567890"}},
+				}},
+			}},
+		}
+		cluster := &controller.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Shoot: &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec:       gardencorev1beta1.ShootSpec{Region: region},
+			},
+			CloudProfile: &gardencorev1beta1.CloudProfile{
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					ProviderConfig: &runtime.RawExtension{Raw: mustMarshalJSON(cloudProfileConfig)},
+				},
+			},
+		}
+		bastionResource := &extensionsv1alpha1.Bastion{ObjectMeta: metav1.ObjectMeta{Name: "my-bastion", Namespace: namespace}}
+
+		fake := &countingEC2{}
+		// wrapping the fake in a single shared CachingInterface mirrors what DefaultFactory
+		// does in production: every Client it hands out for the same region and credentials shares
+		// one cache.
+		awsClient := &awsclient.Client{Region: region, EC2: awsclient.NewCachingInterface(fake, region, time.Minute)}
+
+		_, err := DetermineOptions(context.Background(), c, bastionResource, cluster, awsClient)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = DetermineOptions(context.Background(), c, bastionResource, cluster, awsClient)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fake.describeSecurityGroupsCalls).To(Equal(1), "second call should be served from cache")
+		Expect(fake.describeImagesCalls).To(Equal(1), "second call should be served from cache")
+		Expect(fake.describeInstanceTypeOfferingsCalls).To(Equal(1), "second call should be served from cache")
+	})
+})
+
+func mustMarshalJSON(obj interface{}) []byte {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}